@@ -89,6 +89,58 @@ func BenchmarkTreeParallel(b *testing.B) {
 	})
 }
 
+func BenchmarkTreeInsertSequential(b *testing.B) {
+	b.ReportAllocs()
+	t := tree.New()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		t.Insert(int64(i), struct{}{})
+	}
+}
+
+func BenchmarkTreeInsertHintSequential(b *testing.B) {
+	b.ReportAllocs()
+	t := tree.New()
+	var hint tree.PathHint[int64]
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		t.InsertHint(int64(i), struct{}{}, &hint)
+	}
+}
+
+func BenchmarkTreeGetSequential(b *testing.B) {
+	b.ReportAllocs()
+	t := tree.New()
+	for i := 0; i < 100000; i++ {
+		t.Insert(int64(i), struct{}{})
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		t.Get(int64(i % 100000))
+	}
+}
+
+func BenchmarkTreeGetHintSequential(b *testing.B) {
+	b.ReportAllocs()
+	t := tree.New()
+	var hint tree.PathHint[int64]
+	for i := 0; i < 100000; i++ {
+		t.Insert(int64(i), struct{}{})
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		t.GetHint(int64(i%100000), &hint)
+	}
+}
+
 func findRight(n *tree.Node) {
 	if n == nil {
 		return
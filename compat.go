@@ -0,0 +1,40 @@
+package tree
+
+// Int64Comparator orders int64 keys by their natural ordering.
+func Int64Comparator(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// BinaryTree is the original int64-keyed, interface{}-valued tree, kept as a
+// thin wrapper around Tree for backward compatibility.
+type BinaryTree = Tree[int64, interface{}]
+
+// Node is the original int64-keyed, interface{}-valued node, kept as a thin
+// wrapper around TreeNode for backward compatibility.
+type Node = TreeNode[int64, interface{}]
+
+// New returns a new int64-keyed BinaryTree. It is equivalent to calling
+// NewTree with Int64Comparator.
+func New() *BinaryTree {
+	return NewTree[int64, interface{}](Int64Comparator)
+}
+
+// Traverse traverses a BinaryTree starting at the given node. It is
+// equivalent to calling TraverseTree on an int64-keyed tree.
+func Traverse(n *Node, f func(key int64, value interface{}) (keepGoing bool)) bool {
+	return TraverseTree(n, f)
+}
+
+// HeightFrom measures the height from the given key via traversing a
+// BinaryTree. It is equivalent to calling HeightFromTree with
+// Int64Comparator.
+func HeightFrom(key int64, n *Node) int {
+	return HeightFromTree(Int64Comparator, key, n)
+}
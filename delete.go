@@ -0,0 +1,146 @@
+package tree
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// Delete removes the given key, if present, preserving AVL balance. It
+// follows the same copy-on-write discipline as DropLeft: fresh nodes are
+// allocated along the modified spine, then the new root is published
+// atomically. It returns whether the key was found. This can only be
+// called on the same go-routine as the Insert go-routine. It can be
+// called in parallel with consumers.
+func (t *Tree[K, V]) Delete(key K) bool {
+	r, deleted := t.delete(key, (*TreeNode[K, V])(t.root))
+	if !deleted {
+		return false
+	}
+
+	s := *(*Stat)(atomic.LoadPointer(&t.stats))
+	s.Dropped++
+	atomic.StorePointer(&t.stats, unsafe.Pointer(&s))
+
+	atomic.StorePointer(&t.root, unsafe.Pointer(r))
+	return true
+}
+
+func (t *Tree[K, V]) delete(key K, n *TreeNode[K, V]) (*TreeNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	c := t.cmp(key, n.Key)
+
+	if c < 0 {
+		left, deleted := t.delete(key, n.left)
+		if !deleted {
+			return n, false
+		}
+
+		n = &TreeNode[K, V]{Key: n.Key, Value: n.Value, left: left, right: n.right}
+		t.updateNode(n)
+		return t.rebalance(n), true
+	}
+
+	if c > 0 {
+		right, deleted := t.delete(key, n.right)
+		if !deleted {
+			return n, false
+		}
+
+		n = &TreeNode[K, V]{Key: n.Key, Value: n.Value, left: n.left, right: right}
+		t.updateNode(n)
+		return t.rebalance(n), true
+	}
+
+	// Found the node to remove.
+	if n.left == nil {
+		return n.right, true
+	}
+	if n.right == nil {
+		return n.left, true
+	}
+
+	// Two children: replace with the in-order successor (the left most
+	// node of the right subtree) and delete the successor from the right
+	// subtree.
+	succ := leftMost(n.right)
+	right, _ := t.delete(succ.Key, n.right)
+
+	n = &TreeNode[K, V]{Key: succ.Key, Value: succ.Value, left: n.left, right: right}
+	t.updateNode(n)
+	return t.rebalance(n), true
+}
+
+func leftMost[K, V any](n *TreeNode[K, V]) *TreeNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// rebalance restores the AVL invariant for n using only the heights of its
+// subtrees. Unlike balance, it is not told which key was just touched, so
+// it picks the rotation based on the heavier grandchild - the standard
+// technique for rebalancing after a deletion, where any node along the
+// path back to the root may have become unbalanced.
+func (t *Tree[K, V]) rebalance(n *TreeNode[K, V]) *TreeNode[K, V] {
+	hl := t.findNodeHeight(n.left)
+	hr := t.findNodeHeight(n.right)
+	b := hl - hr
+
+	if b > 1 {
+		if t.findNodeHeight(n.left.left) >= t.findNodeHeight(n.left.right) {
+			return t.rightRotate(n)
+		}
+		n.left = t.leftRotate(n.left)
+		return t.rightRotate(n)
+	}
+
+	if b < -1 {
+		if t.findNodeHeight(n.right.right) >= t.findNodeHeight(n.right.left) {
+			return t.leftRotate(n)
+		}
+		n.right = t.rightRotate(n.right)
+		return t.leftRotate(n)
+	}
+
+	return n
+}
+
+// DropRight removes the right most node, symmetric to DropLeft. If the
+// tree is empty, then it is a nop. This can only be called on the same
+// go-routine as the Insert go-routine. It can be called in parallel with
+// consumers.
+func (t *Tree[K, V]) DropRight() {
+	s := *(*Stat)(atomic.LoadPointer(&t.stats))
+	s.Dropped++
+	atomic.StorePointer(&t.stats, unsafe.Pointer(&s))
+
+	r := t.dropRight((*TreeNode[K, V])(t.root))
+	atomic.StorePointer(&t.root, unsafe.Pointer(r))
+}
+
+func (t *Tree[K, V]) dropRight(n *TreeNode[K, V]) *TreeNode[K, V] {
+	if n == nil {
+		return nil
+	}
+
+	if n.right == nil {
+		// Found right most node
+		return n.left
+	}
+
+	n = &TreeNode[K, V]{
+		Key:   n.Key,
+		Value: n.Value,
+		left:  n.left,
+		right: t.dropRight(n.right),
+	}
+
+	t.updateNode(n)
+	n = t.rebalance(n)
+
+	return n
+}
@@ -0,0 +1,132 @@
+package tree
+
+// Interval is a closed range [Lo, Hi] used as an IntervalTree key.
+type Interval struct {
+	Lo, Hi int64
+}
+
+func compareInterval(a, b Interval) int {
+	switch {
+	case a.Lo < b.Lo:
+		return -1
+	case a.Lo > b.Lo:
+		return 1
+	case a.Hi < b.Hi:
+		return -1
+	case a.Hi > b.Hi:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// intervalValue is what an IntervalTree actually stores at each Interval
+// key. Two inserts can share the exact same [Lo, Hi] range - routine for
+// scheduling or tracing spans - so rather than keying (and colliding) on
+// Interval alone, every value inserted under a given range is chained
+// through next. maxHi is the largest Hi found anywhere in the node's
+// subtree; it is kept in sync with height by the Tree's Augmenter, which is
+// what lets Overlaps prune whole subtrees.
+type intervalValue[V any] struct {
+	value V
+	next  *intervalValue[V]
+
+	maxHi int64
+}
+
+func augmentInterval[V any](n *TreeNode[Interval, intervalValue[V]]) intervalValue[V] {
+	v := n.Value
+	v.maxHi = n.Key.Hi
+
+	if n.left != nil && n.left.Value.maxHi > v.maxHi {
+		v.maxHi = n.left.Value.maxHi
+	}
+	if n.right != nil && n.right.Value.maxHi > v.maxHi {
+		v.maxHi = n.right.Value.maxHi
+	}
+
+	return v
+}
+
+// IntervalTree is a Tree keyed by Interval and augmented with maxHi so
+// Overlaps can answer range-overlap queries in O(log n + k) instead of
+// scanning every interval. It reuses Tree's AVL insert/rotate/balance
+// machinery via an Augmenter rather than forking it.
+type IntervalTree[V any] struct {
+	t *Tree[Interval, intervalValue[V]]
+}
+
+// NewIntervalTree returns a new, empty IntervalTree.
+func NewIntervalTree[V any]() *IntervalTree[V] {
+	return &IntervalTree[V]{
+		t: newAugmentedTree[Interval, intervalValue[V]](compareInterval, augmentInterval[V]),
+	}
+}
+
+// Stats returns the current stats of the tree.
+func (t *IntervalTree[V]) Stats() Stat {
+	return t.t.Stats()
+}
+
+// Insert adds value under iv. Unlike Tree.Insert, a second Insert under an
+// Interval equal to one already present does not replace it - it is kept
+// alongside the existing value or values, since distinct entries sharing a
+// range is the common case for this tree's motivating use cases
+// (scheduling, tracing spans). This can only be called by a single
+// go-routine. However many go-routines can be reading while Insert is being
+// called. Therefore it is a single producer, many consumer.
+func (t *IntervalTree[V]) Insert(iv Interval, value V) {
+	head := intervalValue[V]{value: value}
+
+	if existing, ok := t.t.Get(iv); ok {
+		e := existing
+		head.next = &e
+	}
+
+	t.t.Insert(iv, head)
+}
+
+// DropLeft removes the left most node, including every value chained under
+// it. If the tree is empty, then it is a nop. This can only be called on
+// the same go-routine as the Insert go-routine. It can be called in
+// parallel with consumers.
+func (t *IntervalTree[V]) DropLeft() {
+	t.t.DropLeft()
+}
+
+// Overlaps walks every value whose Interval overlaps [lo, hi], pruning any
+// subtree whose maxHi is less than lo since nothing in it can possibly
+// overlap. This yields O(log n + k) enumeration instead of a full scan.
+// Like Traverse, it short-circuits as soon as f returns false, and it is
+// safe to call concurrently with Insert/DropLeft.
+func (t *IntervalTree[V]) Overlaps(lo, hi int64, f func(iv Interval, value V) (keepGoing bool)) bool {
+	return overlaps(t.t.Root(), lo, hi, f)
+}
+
+func overlaps[V any](n *TreeNode[Interval, intervalValue[V]], lo, hi int64, f func(iv Interval, value V) (keepGoing bool)) bool {
+	if n == nil || n.Value.maxHi < lo {
+		return true
+	}
+
+	if !overlaps(n.Left(), lo, hi, f) {
+		return false
+	}
+
+	if n.Key.Lo <= hi && lo <= n.Key.Hi {
+		for v := &n.Value; v != nil; v = v.next {
+			if !f(n.Key, v.value) {
+				return false
+			}
+		}
+	}
+
+	// Once the node's own Lo is past hi, every interval in its right
+	// subtree (which all have a Lo >= n.Key.Lo) is past hi too.
+	if n.Key.Lo <= hi {
+		if !overlaps(n.Right(), lo, hi, f) {
+			return false
+		}
+	}
+
+	return true
+}
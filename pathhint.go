@@ -0,0 +1,127 @@
+package tree
+
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// maxPathHintDepth bounds how many turns a PathHint remembers. It
+// comfortably covers the height of an AVL tree for any realistic number of
+// entries (AVL height is bounded by roughly 1.44*log2(n+2)).
+const maxPathHintDepth = 96
+
+// PathHint remembers the path taken by the most recent GetHint or
+// InsertHint call against a Tree[K, V]: at each depth, the node visited
+// and the key bounds that depth's turn narrowed the search down to. The
+// bounds describe the key space rather than node identity, so they stay
+// meaningful across the tree's own copy-on-write rewrites; root is the
+// one thing that pins a hint to a particular tree state, since it
+// changes on every Insert/DropLeft. The zero value is an empty hint and
+// is safe to reuse across calls against the same Tree.
+//
+// GetHint uses the bounds to find the deepest recorded depth that still
+// contains the key being looked up, and resumes descending from there
+// instead of restarting at the root. For workloads that revisit nearby
+// keys call after call - monotonically increasing inserts being the
+// canonical case - that depth is almost always the last one recorded, so
+// the search degrades to a handful of comparisons near the bottom of the
+// tree rather than a full O(log n) descent from the root.
+type PathHint[K any] struct {
+	root unsafe.Pointer
+
+	node         [maxPathHintDepth]unsafe.Pointer
+	hasLo, hasHi [maxPathHintDepth]bool
+	lo, hi       [maxPathHintDepth]K
+
+	n int
+}
+
+// entry returns the deepest recorded depth whose bounds still contain
+// key, along with the node recorded there. ok is false if the hint is
+// empty or was recorded against a tree that has since been rewritten by
+// an Insert/DropLeft, in which case the caller should restart at root.
+func (h *PathHint[K]) entry(cmp Comparator[K], key K, root unsafe.Pointer) (depth int, ok bool) {
+	if h.root != root {
+		return 0, false
+	}
+
+	for i := h.n - 1; i >= 0; i-- {
+		if h.hasLo[i] && cmp(key, h.lo[i]) <= 0 {
+			continue
+		}
+		if h.hasHi[i] && cmp(key, h.hi[i]) >= 0 {
+			continue
+		}
+
+		return i, true
+	}
+
+	return 0, false
+}
+
+// InsertHint behaves like Insert, but also refreshes hint with the path
+// this call took so that GetHint calls around it can resume from it.
+// Insert's copy-on-write rebuild already has to touch every node from the
+// root down to the new entry, hint or no hint, so this does not make the
+// insert itself any cheaper - it just keeps the hint warm for the reads
+// that follow. This can only be called by a single go-routine, the same
+// restriction Insert has.
+func (t *Tree[K, V]) InsertHint(key K, value V, hint *PathHint[K]) {
+	r := t.insert(key, value, (*TreeNode[K, V])(t.root))
+	atomic.StorePointer(&t.root, unsafe.Pointer(r))
+
+	hint.root = unsafe.Pointer(r)
+	var zero K
+	t.walkHint(hint, key, 0, r, false, zero, false, zero)
+}
+
+// GetHint behaves like Get, but accepts a PathHint recorded by a previous
+// GetHint/InsertHint call against this tree. If key falls within the
+// bounds of a depth the hint already explored, the search resumes from
+// there instead of the root. hint is updated in place with the path this
+// call actually took. It can be called concurrently with Insert/DropLeft
+// as long as hint is not shared across go-routines.
+func (t *Tree[K, V]) GetHint(key K, hint *PathHint[K]) (value V, ok bool) {
+	root := atomic.LoadPointer(&t.root)
+
+	if depth, resumed := hint.entry(t.cmp, key, root); resumed {
+		n := (*TreeNode[K, V])(hint.node[depth])
+		return t.walkHint(hint, key, depth, n, hint.hasLo[depth], hint.lo[depth], hint.hasHi[depth], hint.hi[depth])
+	}
+
+	hint.root = root
+	var zero K
+	return t.walkHint(hint, key, 0, (*TreeNode[K, V])(root), false, zero, false, zero)
+}
+
+// walkHint descends from n - recorded at depth with the given bounds -
+// looking for key, recording the (possibly extended) path into hint as it
+// goes.
+func (t *Tree[K, V]) walkHint(hint *PathHint[K], key K, depth int, n *TreeNode[K, V], hasLo bool, lo K, hasHi bool, hi K) (value V, ok bool) {
+	for n != nil {
+		if depth < maxPathHintDepth {
+			hint.node[depth] = unsafe.Pointer(n)
+			hint.hasLo[depth], hint.lo[depth] = hasLo, lo
+			hint.hasHi[depth], hint.hi[depth] = hasHi, hi
+		}
+
+		c := t.cmp(key, n.Key)
+		switch {
+		case c < 0:
+			hasHi, hi = true, n.Key
+			n = n.left
+		case c > 0:
+			hasLo, lo = true, n.Key
+			n = n.right
+		default:
+			hint.n = min(depth+1, maxPathHintDepth)
+			return n.Value, true
+		}
+
+		depth++
+	}
+
+	hint.n = min(depth, maxPathHintDepth)
+	var zero V
+	return zero, false
+}
@@ -0,0 +1,109 @@
+package tree
+
+// Get looks up the value stored for key. ok is false if no such key exists.
+// It can be called concurrently with Insert/DropLeft; it only ever reads
+// the atomically published root.
+func (t *Tree[K, V]) Get(key K) (value V, ok bool) {
+	n := t.Root()
+	for n != nil {
+		c := t.cmp(key, n.Key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.Value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Ceiling returns the node with the smallest key that is greater than or
+// equal to key. ok is false if no such node exists.
+func (t *Tree[K, V]) Ceiling(key K) (node *TreeNode[K, V], ok bool) {
+	n := t.Root()
+	var best *TreeNode[K, V]
+
+	for n != nil {
+		c := t.cmp(key, n.Key)
+		switch {
+		case c == 0:
+			return n, true
+		case c < 0:
+			best = n
+			n = n.left
+		default:
+			n = n.right
+		}
+	}
+
+	if best == nil {
+		return nil, false
+	}
+
+	return best, true
+}
+
+// Floor returns the node with the largest key that is less than or equal
+// to key. ok is false if no such node exists.
+func (t *Tree[K, V]) Floor(key K) (node *TreeNode[K, V], ok bool) {
+	n := t.Root()
+	var best *TreeNode[K, V]
+
+	for n != nil {
+		c := t.cmp(key, n.Key)
+		switch {
+		case c == 0:
+			return n, true
+		case c > 0:
+			best = n
+			n = n.right
+		default:
+			n = n.left
+		}
+	}
+
+	if best == nil {
+		return nil, false
+	}
+
+	return best, true
+}
+
+// Range walks the keys in [lo, hi] in order, invoking f for each. It prunes
+// any subtree that falls entirely outside the range, so it only visits the
+// keys in the range rather than the entire tree. Like Traverse, it
+// short-circuits as soon as f returns false. It is safe to call
+// concurrently with Insert/DropLeft.
+func (t *Tree[K, V]) Range(lo, hi K, f func(key K, value V) (keepGoing bool)) bool {
+	return rangeNode(t.cmp, t.Root(), lo, hi, f)
+}
+
+func rangeNode[K, V any](cmp Comparator[K], n *TreeNode[K, V], lo, hi K, f func(key K, value V) (keepGoing bool)) bool {
+	if n == nil {
+		return true
+	}
+
+	if cmp(n.Key, lo) > 0 {
+		if !rangeNode(cmp, n.left, lo, hi, f) {
+			return false
+		}
+	}
+
+	if cmp(n.Key, lo) >= 0 && cmp(n.Key, hi) <= 0 {
+		if !f(n.Key, n.Value) {
+			return false
+		}
+	}
+
+	if cmp(n.Key, hi) < 0 {
+		if !rangeNode(cmp, n.right, lo, hi, f) {
+			return false
+		}
+	}
+
+	return true
+}
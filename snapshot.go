@@ -0,0 +1,77 @@
+package tree
+
+import "unsafe"
+
+// Snapshot is an immutable, safely-shareable view of a Tree as it stood at
+// the moment Snapshot was called. Because insert/delete always allocate
+// fresh nodes along the modified spine rather than mutating existing ones,
+// a Snapshot costs only the pointer load that captures the root -
+// everything reachable from it is frozen for as long as the Snapshot is
+// held, even while the originating Tree keeps changing.
+type Snapshot[K, V any] struct {
+	root  *TreeNode[K, V]
+	stats Stat
+	cmp   Comparator[K]
+}
+
+// Snapshot captures the current state of the tree as a Snapshot.
+func (t *Tree[K, V]) Snapshot() *Snapshot[K, V] {
+	return &Snapshot[K, V]{
+		root:  t.Root(),
+		stats: t.Stats(),
+		cmp:   t.cmp,
+	}
+}
+
+// Root returns the snapshot's root node. If the tree was empty when the
+// Snapshot was taken, it will return nil.
+func (s *Snapshot[K, V]) Root() *TreeNode[K, V] {
+	return s.root
+}
+
+// Get looks up the value stored for key at the time the Snapshot was
+// taken. ok is false if no such key exists.
+func (s *Snapshot[K, V]) Get(key K) (value V, ok bool) {
+	n := s.root
+	for n != nil {
+		c := s.cmp(key, n.Key)
+		switch {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.Value, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Traverse traverses the snapshot starting at its root.
+func (s *Snapshot[K, V]) Traverse(f func(key K, value V) (keepGoing bool)) bool {
+	return TraverseTree(s.root, f)
+}
+
+// Stats returns the tree's stats as they were at the time the Snapshot
+// was taken.
+func (s *Snapshot[K, V]) Stats() Stat {
+	return s.stats
+}
+
+// Clone returns a fully mutable fork of the tree. The fork shares
+// structure with the original until one of them diverges - Insert,
+// InsertHint, Delete, DropLeft, or DropRight on either tree only ever
+// allocates fresh nodes along the modified spine, so Clone costs O(1) and
+// neither tree observes the other's subsequent writes.
+func (t *Tree[K, V]) Clone() *Tree[K, V] {
+	root := t.Root()
+	stats := t.Stats()
+
+	clone := &Tree[K, V]{cmp: t.cmp, augment: t.augment}
+	clone.root = unsafe.Pointer(root)
+	clone.stats = unsafe.Pointer(&stats)
+
+	return clone
+}
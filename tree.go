@@ -5,49 +5,76 @@ import (
 	"unsafe"
 )
 
-// BinaryTree is a self balancing AVL tree.
-type BinaryTree struct {
+// Comparator orders two keys. It must return a negative number if a is
+// less than b, a positive number if a is greater than b, and 0 if they are
+// considered equal.
+type Comparator[K any] func(a, b K) int
+
+// Augmenter recomputes any derived data a tree maintains per node, such as
+// IntervalTree's maxHi, every time a node is rebuilt by Insert, DropLeft, or
+// a rotation. It runs immediately after left and right are set on the new
+// node (and after height is recomputed), and returns the value to store at
+// n, given n's current value and its current children.
+type Augmenter[K, V any] func(n *TreeNode[K, V]) V
+
+// Tree is a self balancing AVL tree, generic over key and value type.
+type Tree[K, V any] struct {
 	root unsafe.Pointer
 
 	// Stat object
 	stats unsafe.Pointer
+
+	cmp     Comparator[K]
+	augment Augmenter[K, V]
 }
 
-// Node stores a tree's vertice.
-type Node struct {
-	Key   int64
-	Value interface{}
+// TreeNode stores a tree's vertice.
+type TreeNode[K, V any] struct {
+	Key   K
+	Value V
 
 	// These should be treated as final. They should never be altered once
 	// set.
-	left, right *Node
+	left, right *TreeNode[K, V]
 
 	height int
 }
 
 // Left returns the left node.
-func (n *Node) Left() *Node {
+func (n *TreeNode[K, V]) Left() *TreeNode[K, V] {
 	return n.left
 }
 
 // Right returns the right node.
-func (n *Node) Right() *Node {
+func (n *TreeNode[K, V]) Right() *TreeNode[K, V] {
 	return n.right
 }
 
-// New returns a new BinaryTree.
-func New() *BinaryTree {
+// NewTree returns a new Tree that orders its keys with the given
+// Comparator.
+func NewTree[K, V any](cmp Comparator[K]) *Tree[K, V] {
+	return newAugmentedTree[K, V](cmp, nil)
+}
+
+// newAugmentedTree returns a new Tree that, in addition to everything
+// NewTree does, runs augment after every node rebuild. It is unexported
+// because only tree types within this package (e.g. IntervalTree) need to
+// maintain their own derived per-node data; everything else can use
+// NewTree.
+func newAugmentedTree[K, V any](cmp Comparator[K], augment Augmenter[K, V]) *Tree[K, V] {
 	var s Stat
 
-	return &BinaryTree{
-		stats: unsafe.Pointer(&s),
+	return &Tree[K, V]{
+		stats:   unsafe.Pointer(&s),
+		cmp:     cmp,
+		augment: augment,
 	}
 }
 
 // Root returns the tree's root node. If the tree is empty, it will return
 // nil.
-func (t *BinaryTree) Root() *Node {
-	return (*Node)(atomic.LoadPointer(&t.root))
+func (t *Tree[K, V]) Root() *TreeNode[K, V] {
+	return (*TreeNode[K, V])(atomic.LoadPointer(&t.root))
 }
 
 // Stat is the result of calling the Stats method.
@@ -58,140 +85,158 @@ type Stat struct {
 }
 
 // Stats returns the current stats of the tree.
-func (t *BinaryTree) Stats() Stat {
+func (t *Tree[K, V]) Stats() Stat {
 	s := *(*Stat)(atomic.LoadPointer(&t.stats))
 	s.Size = s.Added - s.Dropped
 	return s
 }
 
-// Insert adds an entry to the BinaryTree. This can only be called by a single
+// Insert adds an entry to the Tree. This can only be called by a single
 // go-routine. However many go-routines can be reading while Insert is being
 // called. Therefore it is a single producer, many consumer.
-func (t *BinaryTree) Insert(key int64, value interface{}) {
-	r := t.insert(key, value, (*Node)(t.root))
+func (t *Tree[K, V]) Insert(key K, value V) {
+	r := t.insert(key, value, (*TreeNode[K, V])(t.root))
 	atomic.StorePointer(&t.root, unsafe.Pointer(r))
 }
 
-func (t *BinaryTree) insert(key int64, value interface{}, n *Node) *Node {
+func (t *Tree[K, V]) insert(key K, value V, n *TreeNode[K, V]) *TreeNode[K, V] {
 	if n == nil {
 		s := *(*Stat)(atomic.LoadPointer(&t.stats))
 		s.Added++
 		atomic.StorePointer(&t.stats, unsafe.Pointer(&s))
-		return &Node{Key: key, Value: value, height: 1}
+		n := &TreeNode[K, V]{Key: key, Value: value, height: 1}
+		t.updateNode(n)
+		return n
 	}
 
-	if key < n.Key {
+	if t.cmp(key, n.Key) < 0 {
 		left := t.insert(key, value, n.left)
-		n = &Node{
+		n = &TreeNode[K, V]{
 			Key:   n.Key,
 			Value: n.Value,
 			left:  left,
 			right: n.right,
 		}
-
-		n.height = t.findHeight(n.left, n.right)
+		t.updateNode(n)
 
 		return t.balance(n, key)
 	}
 
-	if key > n.Key {
+	if t.cmp(key, n.Key) > 0 {
 		right := t.insert(key, value, n.right)
 
-		n = &Node{
+		n = &TreeNode[K, V]{
 			Key:   n.Key,
 			Value: n.Value,
 			left:  n.left,
 			right: right,
 		}
-		n.height = t.findHeight(n.left, n.right)
+		t.updateNode(n)
 
 		return t.balance(n, key)
 	}
 
-	return &Node{
+	n = &TreeNode[K, V]{
 		Key:    key,
 		Value:  value,
 		left:   n.left,
 		right:  n.right,
 		height: n.height,
 	}
+	t.updateNode(n)
+
+	return n
+}
+
+// updateNode recomputes n's height from its children and, if the tree was
+// constructed with an Augmenter, lets it recompute n's value too. Every
+// place that rebuilds a node (insert, a rotation, dropLeft) goes through
+// this instead of setting height directly, so augmented data never drifts
+// out of sync with the tree shape.
+func (t *Tree[K, V]) updateNode(n *TreeNode[K, V]) {
+	n.height = t.findHeight(n.left, n.right)
+
+	if t.augment != nil {
+		n.Value = t.augment(n)
+	}
 }
 
-func (t *BinaryTree) rightRotate(y *Node) *Node {
+func (t *Tree[K, V]) rightRotate(y *TreeNode[K, V]) *TreeNode[K, V] {
 	x := y.left
 	t2 := x.right
 
-	y = &Node{
+	y = &TreeNode[K, V]{
 		Key:   y.Key,
 		Value: y.Value,
 		left:  t2,
 		right: y.right,
 	}
 
-	x = &Node{
+	x = &TreeNode[K, V]{
 		Key:   x.Key,
 		Value: x.Value,
 		left:  x.left,
 		right: y,
 	}
 
-	y.height = t.findNodeHeight(y)
-	x.height = t.findNodeHeight(x)
+	t.updateNode(y)
+	t.updateNode(x)
 
 	return x
 }
 
-func (t *BinaryTree) leftRotate(x *Node) *Node {
+func (t *Tree[K, V]) leftRotate(x *TreeNode[K, V]) *TreeNode[K, V] {
 	y := x.right
 	t2 := y.left
 
-	x = &Node{
+	x = &TreeNode[K, V]{
 		Key:   x.Key,
 		Value: x.Value,
 		left:  x.left,
 		right: t2,
 	}
 
-	y = &Node{
+	y = &TreeNode[K, V]{
 		Key:   y.Key,
 		Value: y.Value,
 		left:  x,
 		right: y.right,
 	}
 
-	x.height = t.findNodeHeight(x)
-	y.height = t.findNodeHeight(y)
+	t.updateNode(x)
+	t.updateNode(y)
 
 	return y
 }
 
-func (t *BinaryTree) balance(n *Node, key int64) *Node {
+func (t *Tree[K, V]) balance(n *TreeNode[K, V], key K) *TreeNode[K, V] {
 	hl := t.findNodeHeight(n.left)
 	hr := t.findNodeHeight(n.right)
 	b := hl - hr
 
 	// Left Left
-	if b > 1 && key < n.left.Key {
+	if b > 1 && t.cmp(key, n.left.Key) < 0 {
 		return t.rightRotate(n)
 	}
 
 	// Right Right
-	if b < -1 && key > n.right.Key {
+	if b < -1 && t.cmp(key, n.right.Key) > 0 {
 		return t.leftRotate(n)
 	}
 
 	// Left Right
-	if b > 1 && key > n.left.Key {
+	if b > 1 && t.cmp(key, n.left.Key) > 0 {
 		n.left = t.leftRotate(n.left)
 		return t.rightRotate(n)
 	}
 
 	// Right Left
-	if b < -1 && key < n.right.Key {
+	if b < -1 && t.cmp(key, n.right.Key) < 0 {
 
-		// Check to see if we just dropped the left most node (without
-		// balancing)
-		if n.left == nil || n.right.left == nil {
+		// rightRotate(n.right) requires n.right.left to exist; n.left being
+		// nil is unrelated to whether this rotation is valid and must not
+		// skip it.
+		if n.right.left == nil {
 			return n
 		}
 
@@ -205,16 +250,16 @@ func (t *BinaryTree) balance(n *Node, key int64) *Node {
 // DropLeft removes the left most node. If the tree is empty, then it is a
 // nop. This can only be called on the same go-routine as the Insert
 // go-routine. It can be called in parallel with consumers.
-func (t *BinaryTree) DropLeft() {
+func (t *Tree[K, V]) DropLeft() {
 	s := *(*Stat)(atomic.LoadPointer(&t.stats))
 	s.Dropped++
 	atomic.StorePointer(&t.stats, unsafe.Pointer(&s))
 
-	r := t.dropLeft((*Node)(t.root))
+	r := t.dropLeft((*TreeNode[K, V])(t.root))
 	atomic.StorePointer(&t.root, unsafe.Pointer(r))
 }
 
-func (t *BinaryTree) dropLeft(n *Node) *Node {
+func (t *Tree[K, V]) dropLeft(n *TreeNode[K, V]) *TreeNode[K, V] {
 	if n == nil {
 		return nil
 	}
@@ -224,19 +269,20 @@ func (t *BinaryTree) dropLeft(n *Node) *Node {
 		return n.right
 	}
 
-	n = &Node{
+	n = &TreeNode[K, V]{
 		Key:   n.Key,
 		Value: n.Value,
 		left:  t.dropLeft(n.left),
 		right: n.right,
 	}
 
-	n.height = t.findNodeHeight(n)
+	t.updateNode(n)
+	n = t.rebalance(n)
 
 	return n
 }
 
-func (t *BinaryTree) findNodeHeight(n *Node) int {
+func (t *Tree[K, V]) findNodeHeight(n *TreeNode[K, V]) int {
 	if n == nil {
 		return 0
 	}
@@ -244,7 +290,7 @@ func (t *BinaryTree) findNodeHeight(n *Node) int {
 	return t.findHeight(n.left, n.right)
 }
 
-func (t *BinaryTree) findHeight(l, r *Node) int {
+func (t *Tree[K, V]) findHeight(l, r *TreeNode[K, V]) int {
 	var hl, hr int
 
 	if l != nil {
@@ -262,42 +308,43 @@ func (t *BinaryTree) findHeight(l, r *Node) int {
 	return hr + 1
 }
 
-// Traverse is used to traverse a tree starting at the given node.
-func Traverse(n *Node, f func(key int64, value interface{}) (keepGoing bool)) bool {
+// TraverseTree is used to traverse a tree starting at the given node.
+func TraverseTree[K, V any](n *TreeNode[K, V], f func(key K, value V) (keepGoing bool)) bool {
 	if n == nil {
 		return true
 	}
 
-	if !Traverse(n.Left(), f) {
+	if !TraverseTree(n.Left(), f) {
 		return false
 	}
 
 	if !f(n.Key, n.Value) {
 		return false
 	}
-	if !Traverse(n.Right(), f) {
+	if !TraverseTree(n.Right(), f) {
 		return false
 	}
 
 	return true
 }
 
-// HeightFrom measures the height from the given key via traversing.
-func HeightFrom(key int64, n *Node) int {
-	return heightFrom(key, 0, n)
+// HeightFromTree measures the height from the given key via traversing. It
+// uses cmp to walk the tree in the same order the tree was built with.
+func HeightFromTree[K, V any](cmp Comparator[K], key K, n *TreeNode[K, V]) int {
+	return heightFrom(cmp, key, 0, n)
 }
 
-func heightFrom(key int64, count int, n *Node) int {
+func heightFrom[K, V any](cmp Comparator[K], key K, count int, n *TreeNode[K, V]) int {
 	if n == nil {
 		return 0
 	}
 
-	if key < n.Key {
-		return heightFrom(key, count+1, (*Node)(n.left))
+	if cmp(key, n.Key) < 0 {
+		return heightFrom(cmp, key, count+1, n.left)
 	}
 
-	if key > n.Key {
-		return heightFrom(key, count+1, (*Node)(n.right))
+	if cmp(key, n.Key) > 0 {
+		return heightFrom(cmp, key, count+1, n.right)
 	}
 
 	return count + 1
@@ -2,7 +2,6 @@ package tree_test
 
 import (
 	"fmt"
-	"math"
 	"math/rand"
 	"sort"
 	"testing"
@@ -89,6 +88,132 @@ func TestTree(t *testing.T) {
 		}))
 	})
 
+	o.Spec("Get finds the value for a key and reports misses", func(t TT) {
+		for _, i := range []int64{5, 3, 6, 4, 2} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		value, ok := t.bt.Get(4)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, value).To(Equal("4"))
+
+		_, ok = t.bt.Get(100)
+		Expect(t, ok).To(BeFalse())
+	})
+
+	o.Spec("Ceiling and Floor find the closest node", func(t TT) {
+		for _, i := range []int64{5, 3, 6, 4, 2} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		ceil, ok := t.bt.Ceiling(4)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, ceil.Key).To(Equal(int64(4)))
+
+		_, ok = t.bt.Ceiling(7)
+		Expect(t, ok).To(BeFalse())
+
+		_, ok = t.bt.Floor(1)
+		Expect(t, ok).To(BeFalse())
+
+		ceil, ok = t.bt.Ceiling(1)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, ceil.Key).To(Equal(int64(2)))
+
+		floor, ok := t.bt.Floor(5)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, floor.Key).To(Equal(int64(5)))
+	})
+
+	o.Spec("Range walks only the keys between lo and hi", func(t TT) {
+		for _, i := range []int64{7, 0, 5, 1, 9, 2, 6, 12, 11, 3, 8, 13, 10, 4} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		var keys []int64
+		t.bt.Range(3, 8, func(key int64, value interface{}) bool {
+			keys = append(keys, key)
+			return true
+		})
+
+		Expect(t, keys).To(Equal([]int64{
+			3, 4, 5, 6, 7, 8,
+		}))
+	})
+
+	o.Spec("Range stops early when f returns false", func(t TT) {
+		for _, i := range []int64{7, 0, 5, 1, 9, 2, 6, 12, 11, 3, 8, 13, 10, 4} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		var keys []int64
+		t.bt.Range(3, 8, func(key int64, value interface{}) bool {
+			keys = append(keys, key)
+			return key < 5
+		})
+
+		Expect(t, keys).To(Equal([]int64{
+			3, 4, 5,
+		}))
+	})
+
+	o.Spec("InsertHint produces the same tree as Insert for sequential keys", func(t TT) {
+		var hint tree.PathHint[int64]
+		for i := int64(0); i < 100; i++ {
+			t.bt.InsertHint(i, fmt.Sprintf("%d", i), &hint)
+		}
+
+		Expect(t, t.bt.Stats().Size).To(Equal(100))
+
+		var keys []int64
+		tree.Traverse(t.bt.Root(), func(key int64, value interface{}) bool {
+			keys = append(keys, key)
+			return true
+		})
+
+		Expect(t, sort.IsSorted(ints(keys))).To(BeTrue())
+		Expect(t, len(keys)).To(Equal(100))
+	})
+
+	o.Spec("InsertHint stays correct when keys jump around after the hint was warmed", func(t TT) {
+		var hint tree.PathHint[int64]
+		for i := int64(0); i < 50; i++ {
+			t.bt.InsertHint(i, fmt.Sprintf("%d", i), &hint)
+		}
+
+		for _, i := range []int64{-10, 25, 1000, 3, -3} {
+			t.bt.InsertHint(i, fmt.Sprintf("%d", i), &hint)
+		}
+
+		var keys []int64
+		tree.Traverse(t.bt.Root(), func(key int64, value interface{}) bool {
+			keys = append(keys, key)
+			return true
+		})
+
+		Expect(t, sort.IsSorted(ints(keys))).To(BeTrue())
+
+		// 25 and 3 are already in the tree from the first loop, so only
+		// -10, 1000, and -3 are new entries.
+		Expect(t, t.bt.Stats().Size).To(Equal(53))
+	})
+
+	o.Spec("GetHint finds the same values Get does", func(t TT) {
+		var hint tree.PathHint[int64]
+		for i := int64(0); i < 50; i++ {
+			t.bt.InsertHint(i, fmt.Sprintf("%d", i), &hint)
+		}
+
+		for i := int64(0); i < 50; i++ {
+			value, ok := t.bt.GetHint(i, &hint)
+			Expect(t, ok).To(BeTrue())
+			Expect(t, value).To(Equal(fmt.Sprintf("%d", i)))
+		}
+
+		_, ok := t.bt.GetHint(100, &hint)
+		Expect(t, ok).To(BeFalse())
+	})
+
 	o.Spec("it balances for Left Left", func(t TT) {
 		//  T1, T2, T3 and T4 are subtrees.
 		//        z                                      y
@@ -230,24 +355,225 @@ func TestTree(t *testing.T) {
 		}))
 	})
 
+	o.Spec("Delete removes an arbitrary key and keeps the tree sorted", func(t TT) {
+		values := []int64{7, 0, 5, 1, 9, 2, 6, 12, 11, 3, 8, 13, 10, 4}
+		for _, i := range values {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		Expect(t, t.bt.Delete(9)).To(BeTrue())
+		Expect(t, t.bt.Stats()).To(Equal(tree.Stat{
+			Added:   len(values),
+			Dropped: 1,
+			Size:    len(values) - 1,
+		}))
+
+		_, ok := t.bt.Get(9)
+		Expect(t, ok).To(BeFalse())
+
+		var keys []int64
+		tree.Traverse(t.bt.Root(), func(key int64, value interface{}) bool {
+			keys = append(keys, key)
+			return true
+		})
+
+		Expect(t, sort.IsSorted(ints(keys))).To(BeTrue())
+		Expect(t, len(keys)).To(Equal(len(values) - 1))
+	})
+
+	o.Spec("Delete is a nop when the key is not present", func(t TT) {
+		for _, i := range []int64{5, 3, 6, 4, 2} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		Expect(t, t.bt.Delete(100)).To(BeFalse())
+		Expect(t, t.bt.Stats().Size).To(Equal(5))
+	})
+
+	o.Spec("Delete on a node with two children promotes the in-order successor", func(t TT) {
+		//     5
+		//   /   \
+		//  3     8
+		// / \   / \
+		//2   4 6   9
+		for _, i := range []int64{5, 3, 8, 2, 4, 6, 9} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		Expect(t, t.bt.Delete(5)).To(BeTrue())
+		Expect(t, t.bt.Root().Key).To(Equal(int64(6)))
+
+		var keys []int64
+		tree.Traverse(t.bt.Root(), func(key int64, value interface{}) bool {
+			keys = append(keys, key)
+			return true
+		})
+
+		Expect(t, keys).To(Equal([]int64{
+			2, 3, 4, 6, 8, 9,
+		}))
+	})
+
+	o.Spec("drops the right node and keeps the left", func(t TT) {
+		//        5
+		//      /   \
+		//     3     6
+		//    / \
+		//   2   4
+		for _, i := range []int64{5, 3, 6, 4, 2} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		t.bt.DropRight()
+
+		Expect(t, t.bt.Stats()).To(Equal(tree.Stat{
+			Added:   5,
+			Dropped: 1,
+			Size:    4,
+		}))
+
+		var keys []int64
+		tree.Traverse(t.bt.Root(), func(key int64, value interface{}) bool {
+			keys = append(keys, key)
+			return true
+		})
+
+		Expect(t, keys).To(Equal([]int64{
+			2, 3, 4, 5,
+		}))
+	})
+
+	o.Spec("Snapshot freezes the tree as of the call, regardless of later writes", func(t TT) {
+		for _, i := range []int64{5, 3, 6, 4, 2} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		snap := t.bt.Snapshot()
+
+		t.bt.Insert(100, "100")
+		t.bt.Delete(3)
+
+		value, ok := snap.Get(3)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, value).To(Equal("3"))
+
+		_, ok = snap.Get(100)
+		Expect(t, ok).To(BeFalse())
+
+		Expect(t, snap.Stats()).To(Equal(tree.Stat{
+			Added:   5,
+			Dropped: 0,
+			Size:    5,
+		}))
+
+		var keys []int64
+		snap.Traverse(func(key int64, value interface{}) bool {
+			keys = append(keys, key)
+			return true
+		})
+
+		Expect(t, keys).To(Equal([]int64{
+			2, 3, 4, 5, 6,
+		}))
+	})
+
+	o.Spec("Clone forks a fully mutable tree that shares structure until it diverges", func(t TT) {
+		for _, i := range []int64{5, 3, 6, 4, 2} {
+			t.bt.Insert(i, fmt.Sprintf("%d", i))
+		}
+
+		clone := t.bt.Clone()
+
+		clone.Insert(100, "100")
+		t.bt.Delete(3)
+
+		_, ok := t.bt.Get(100)
+		Expect(t, ok).To(BeFalse())
+
+		value, ok := clone.Get(100)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, value).To(Equal("100"))
+
+		_, ok = t.bt.Get(3)
+		Expect(t, ok).To(BeFalse())
+
+		value, ok = clone.Get(3)
+		Expect(t, ok).To(BeTrue())
+		Expect(t, value).To(Equal("3"))
+	})
+
+	o.Spec("Overlaps finds only the intervals that overlap the query range", func(t TT) {
+		it := tree.NewIntervalTree[string]()
+
+		intervals := []tree.Interval{
+			{Lo: 5, Hi: 10},
+			{Lo: 1, Hi: 2},
+			{Lo: 15, Hi: 25},
+			{Lo: 8, Hi: 12},
+			{Lo: 20, Hi: 22},
+			{Lo: 30, Hi: 40},
+		}
+		for _, iv := range intervals {
+			it.Insert(iv, fmt.Sprintf("%d-%d", iv.Lo, iv.Hi))
+		}
+
+		var got []tree.Interval
+		it.Overlaps(9, 16, func(iv tree.Interval, value string) bool {
+			got = append(got, iv)
+			return true
+		})
+
+		sort.Slice(got, func(i, j int) bool {
+			return got[i].Lo < got[j].Lo
+		})
+
+		Expect(t, got).To(Equal([]tree.Interval{
+			{Lo: 5, Hi: 10},
+			{Lo: 8, Hi: 12},
+			{Lo: 15, Hi: 25},
+		}))
+	})
+
+	o.Spec("Overlaps stops early when f returns false", func(t TT) {
+		it := tree.NewIntervalTree[string]()
+
+		for _, iv := range []tree.Interval{
+			{Lo: 1, Hi: 2},
+			{Lo: 3, Hi: 4},
+			{Lo: 5, Hi: 6},
+		} {
+			it.Insert(iv, "")
+		}
+
+		var count int
+		it.Overlaps(0, 10, func(iv tree.Interval, value string) bool {
+			count++
+			return false
+		})
+
+		Expect(t, count).To(Equal(1))
+	})
+
 	o.Spec("fuzz", func(t TT) {
 		rand.Seed(time.Now().UnixNano())
 
-		var j int64
+		var inserted []int64
 		for i := int64(0); i < 1000; i++ {
 			value := rand.Int63()
 			t.bt.Insert(value, "")
+			inserted = append(inserted, value)
 
 			if i%10 == 0 {
 				t.bt.DropLeft()
-				j++
 			}
 
-			perfectHeight := int(math.Ceil(math.Log2(float64(i-j+2))) - 1)
-			h := tree.HeightFrom(value, t.bt.Root())
+			if i%13 == 0 && len(inserted) > 0 {
+				idx := rand.Intn(len(inserted))
+				t.bt.Delete(inserted[idx])
+				inserted = append(inserted[:idx], inserted[idx+1:]...)
+			}
 
-			// We'll allow for some wiggle room.
-			Expect(t, h-perfectHeight).To(BeBelow(5))
+			assertBalanced(t, t.bt.Root())
 
 			var keys []int64
 			tree.Traverse(t.bt.Root(), func(key int64, value interface{}) bool {
@@ -290,6 +616,25 @@ func TestTree(t *testing.T) {
 	})
 }
 
+// assertBalanced fails t if any node in n's subtree violates the AVL
+// invariant (|height(left)-height(right)| <= 1), and returns n's height.
+func assertBalanced(t TT, n *tree.Node) int {
+	if n == nil {
+		return 0
+	}
+
+	hl := assertBalanced(t, n.Left())
+	hr := assertBalanced(t, n.Right())
+
+	d := hl - hr
+	Expect(t, d >= -1 && d <= 1).To(BeTrue())
+
+	if hl > hr {
+		return hl + 1
+	}
+	return hr + 1
+}
+
 type ints []int64
 
 func (i ints) Len() int {